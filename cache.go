@@ -0,0 +1,203 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// cacheBackend is the pluggable interface backing withCache. The data
+// served by this API is effectively static, so responses can be cached
+// aggressively without a complex invalidation scheme.
+type cacheBackend interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+	Purge(ctx context.Context)
+}
+
+var cache cacheBackend
+var cacheTTL time.Duration
+
+// initCache builds the cache backend selected by CACHE_DRIVER ("memory",
+// the default, or "redis"), using CACHE_TTL (seconds) and REDIS_URL.
+func initCache() {
+	cacheDriver := getEnv("CACHE_DRIVER", "memory")
+	ttlSeconds, err := strconv.Atoi(getEnv("CACHE_TTL", "300"))
+	if err != nil {
+		slog.Info("Invalid CACHE_TTL, falling back to 300 seconds", "err", err)
+		ttlSeconds = 300
+	}
+	cacheTTL = time.Duration(ttlSeconds) * time.Second
+
+	switch cacheDriver {
+	case "redis":
+		redisURL := getEnv("REDIS_URL", "redis://localhost:6379/0")
+		opts, err := redis.ParseURL(redisURL)
+		if err != nil {
+			slog.Error("Error parsing REDIS_URL", "err", err)
+			os.Exit(1)
+		}
+		cache = &redisCache{client: redis.NewClient(opts)}
+		slog.Info("Using Redis cache backend")
+	default:
+		cache = newMemoryCache(1000)
+		slog.Info("Using in-memory LRU cache backend")
+	}
+}
+
+// memoryCache is a small fixed-capacity, TTL-aware LRU used when
+// CACHE_DRIVER is unset or "memory".
+type memoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func newMemoryCache(capacity int) *memoryCache {
+	return &memoryCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (m *memoryCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		m.order.Remove(el)
+		delete(m.items, key)
+		return nil, false
+	}
+	m.order.MoveToFront(el)
+	return entry.value, true
+}
+
+func (m *memoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.items[key]; ok {
+		m.order.MoveToFront(el)
+		entry := el.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expiresAt = time.Now().Add(ttl)
+		return
+	}
+
+	el := m.order.PushFront(&memoryCacheEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)})
+	m.items[key] = el
+	if m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		if oldest != nil {
+			m.order.Remove(oldest)
+			delete(m.items, oldest.Value.(*memoryCacheEntry).key)
+		}
+	}
+}
+
+func (m *memoryCache) Purge(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items = make(map[string]*list.Element)
+	m.order = list.New()
+}
+
+// redisCache backs the cache with Redis via go-redis, for deployments
+// that share the cache across multiple instances of the service.
+type redisCache struct {
+	client *redis.Client
+}
+
+func (r *redisCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	val, err := r.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			slog.Error("Error reading from redis cache", "key", key, "err", err)
+		}
+		return nil, false
+	}
+	return val, true
+}
+
+func (r *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	if err := r.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		slog.Error("Error writing to redis cache", "key", key, "err", err)
+	}
+}
+
+func (r *redisCache) Purge(ctx context.Context) {
+	if err := r.client.FlushDB(ctx).Err(); err != nil {
+		slog.Error("Error purging redis cache", "err", err)
+	}
+}
+
+// cacheKey builds a stable cache key from the endpoint name and its
+// request parameters.
+func cacheKey(endpoint string, params ...string) string {
+	key := "wilayah:" + endpoint
+	for _, p := range params {
+		key += ":" + p
+	}
+	return key
+}
+
+// withCache serves the JSON response for (endpoint, params) from cache
+// when present, otherwise calls fn to compute it, caches a 200 result,
+// and always sets X-Cache to HIT or MISS.
+func withCache(c *gin.Context, endpoint string, params []string, fn func() (interface{}, int)) {
+	key := cacheKey(endpoint, params...)
+	if cached, ok := cache.Get(c.Request.Context(), key); ok {
+		c.Header("X-Cache", "HIT")
+		c.Data(200, "application/json; charset=utf-8", cached)
+		return
+	}
+
+	value, status := fn()
+	c.Header("X-Cache", "MISS")
+	if status == 200 {
+		if encoded, err := json.Marshal(value); err == nil {
+			cache.Set(c.Request.Context(), key, encoded, cacheTTL)
+		}
+	}
+	c.JSON(status, value)
+}
+
+// purgeCache handles POST /admin/cache/purge, guarded by the
+// ADMIN_API_KEY env var supplied as the X-Api-Key header. Intended to be
+// called after re-seeding so stale reads aren't served from cache.
+func purgeCache(c *gin.Context) {
+	apiKey := getEnv("ADMIN_API_KEY", "")
+	provided := c.GetHeader("X-Api-Key")
+	if apiKey == "" || subtle.ConstantTimeCompare([]byte(apiKey), []byte(provided)) != 1 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or missing API key"})
+		return
+	}
+
+	cache.Purge(c.Request.Context())
+	slog.Info("Cache purged via /admin/cache/purge")
+	c.JSON(200, gin.H{"status": "purged"})
+}
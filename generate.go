@@ -0,0 +1,7 @@
+package main
+
+// Regenerate the protobuf/gRPC/gateway stubs from proto/wilayah.proto.
+// Requires protoc plus protoc-gen-go, protoc-gen-go-grpc and
+// protoc-gen-grpc-gateway on PATH, and the googleapis annotations proto
+// checked out under third_party/googleapis.
+//go:generate protoc -I proto -I third_party/googleapis --go_out=proto --go_opt=paths=source_relative --go-grpc_out=proto --go-grpc_opt=paths=source_relative --grpc-gateway_out=proto --grpc-gateway_opt=paths=source_relative proto/wilayah.proto
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/dhconnelly/rtreego"
+	"github.com/gin-gonic/gin"
+)
+
+// geoLevels lists the wilayah tables from most to least specific, the
+// order reverse geocoding tries them in: a point is reported against the
+// smallest enclosing/nearest region first.
+var geoLevels = []struct {
+	table     string
+	idColumn  string
+	ancestors []string
+}{
+	{"kelurahan_desas", "id", []string{"kecamatans", "kab_kotas", "provinsis"}},
+	{"kecamatans", "id", []string{"kab_kotas", "provinsis"}},
+	{"kab_kotas", "id", []string{"provinsis"}},
+	{"provinsis", "id", nil},
+}
+
+// geocode handles GET /geocode?lat=&lon=, returning the enclosing (or, on
+// MySQL, nearest) provinsi/kabupaten/kecamatan/kelurahan for a coordinate.
+func geocode(c *gin.Context) {
+	lat, err := strconv.ParseFloat(c.Query("lat"), 64)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "lat is required and must be a number"})
+		return
+	}
+	lon, err := strconv.ParseFloat(c.Query("lon"), 64)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "lon is required and must be a number"})
+		return
+	}
+
+	var kelurahanID int
+	var found bool
+	if driver == "postgres" {
+		kelurahanID, found = geocodePostgres(lat, lon)
+	} else {
+		kelurahanID, found = geocodeMySQL(lat, lon)
+	}
+	if !found {
+		c.JSON(404, gin.H{"error": "no enclosing wilayah found for the given coordinates"})
+		return
+	}
+
+	chain, err := resolveKelurahanChain(kelurahanID)
+	if err != nil {
+		slog.Error("Error resolving geocode chain", "kelurahan_id", kelurahanID, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(200, chain)
+}
+
+// geocodePostgres finds the kelurahan whose PostGIS boundary polygon
+// contains the point, via ST_Contains. Since seed boundary data may be
+// incomplete, it falls back level by level to coarser tables and uses the
+// most specific level that does have a matching polygon.
+func geocodePostgres(lat, lon float64) (int, bool) {
+	for _, level := range geoLevels {
+		query := fmt.Sprintf(`SELECT id FROM %s WHERE boundary IS NOT NULL AND ST_Contains(boundary, ST_SetSRID(ST_MakePoint(?, ?), 4326)) LIMIT 1`, level.table)
+		query = convertToPostgres(query)
+
+		var id int
+		if err := db.QueryRow(query, lon, lat).Scan(&id); err != nil {
+			if err != sql.ErrNoRows {
+				slog.Error("Error running ST_Contains", "table", level.table, "err", err)
+			}
+			continue
+		}
+
+		if level.table == "kelurahan_desas" {
+			return id, true
+		}
+		return narrowestDescendantOf(level.table, id)
+	}
+	return 0, false
+}
+
+// geocodeMySQL approximates reverse geocoding with nearest-centroid
+// lookup via an in-memory R-tree, since MySQL (unlike Postgres+PostGIS)
+// has no polygon containment support built into this service.
+func geocodeMySQL(lat, lon float64) (int, bool) {
+	id, ok := nearestInTable("kelurahan_desas", lat, lon)
+	if ok {
+		return id, true
+	}
+	return 0, false
+}
+
+// narrowestDescendantOf returns *a* kelurahan under the given ancestor
+// table/id, used when reverse geocoding only matched a coarser level
+// (e.g. only the kecamatan has boundary data) so the response can still
+// be expressed in terms of the full ancestry chain.
+func narrowestDescendantOf(table string, id int) (int, bool) {
+	query := ""
+	switch table {
+	case "provinsis":
+		query = `SELECT d.id FROM kelurahan_desas d
+			JOIN kecamatans c ON d.kecamatan_id = c.id
+			JOIN kab_kotas k ON c.kab_kota_id = k.id
+			WHERE k.provinsi_id = ? LIMIT 1`
+	case "kab_kotas":
+		query = `SELECT d.id FROM kelurahan_desas d
+			JOIN kecamatans c ON d.kecamatan_id = c.id
+			WHERE c.kab_kota_id = ? LIMIT 1`
+	case "kecamatans":
+		query = `SELECT id FROM kelurahan_desas WHERE kecamatan_id = ? LIMIT 1`
+	default:
+		return 0, false
+	}
+	query = convertToPostgres(query)
+
+	var kelurahanID int
+	if err := db.QueryRow(query, id).Scan(&kelurahanID); err != nil {
+		return 0, false
+	}
+	return kelurahanID, true
+}
+
+// resolveKelurahanChain loads the full provinsi->kabupaten->kecamatan->
+// kelurahan ancestry for a kelurahan id, the same shape returned by
+// getLookupKelurahan but without its "siblings" list.
+func resolveKelurahanChain(kelurahanID int) (gin.H, error) {
+	query := `SELECT p.id, p.nama_provinsi, k.id, k.nama_kab_kota, c.id, c.nama_kecamatan, d.id, d.nama_kelurahan_desa
+		FROM kelurahan_desas d
+		JOIN kecamatans c ON d.kecamatan_id = c.id
+		JOIN kab_kotas k ON c.kab_kota_id = k.id
+		JOIN provinsis p ON k.provinsi_id = p.id
+		WHERE d.id = ?`
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	var provinsiID, kabupatenID, kecamatanID, kelurahanIDOut int
+	var provinsiNama, kabupatenNama, kecamatanNama, kelurahanNama string
+	if err := db.QueryRow(query, kelurahanID).Scan(&provinsiID, &provinsiNama, &kabupatenID, &kabupatenNama, &kecamatanID, &kecamatanNama, &kelurahanIDOut, &kelurahanNama); err != nil {
+		return nil, err
+	}
+
+	return gin.H{
+		"provinsi":  gin.H{"id": provinsiID, "nama": provinsiNama},
+		"kabupaten": gin.H{"id": kabupatenID, "nama": kabupatenNama},
+		"kecamatan": gin.H{"id": kecamatanID, "nama": kecamatanNama},
+		"kelurahan": gin.H{"id": kelurahanIDOut, "nama": kelurahanNama},
+	}, nil
+}
+
+// getKelurahanGeometry handles GET /kelurahan/:id/geometry, returning the
+// stored boundary as a GeoJSON geometry object.
+func getKelurahanGeometry(c *gin.Context) {
+	id := c.Param("id")
+
+	var boundary sql.NullString
+	if driver == "postgres" {
+		query := convertToPostgres(`SELECT ST_AsGeoJSON(boundary) FROM kelurahan_desas WHERE id = ?`)
+		if err := db.QueryRow(query, id).Scan(&boundary); err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+	} else {
+		if err := db.QueryRow(`SELECT boundary FROM kelurahan_desas WHERE id = ?`, id).Scan(&boundary); err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if !boundary.Valid {
+		c.JSON(404, gin.H{"error": "no geometry stored for this kelurahan"})
+		return
+	}
+
+	c.Data(200, "application/geo+json; charset=utf-8", []byte(boundary.String))
+}
+
+// geoPoint is the rtreego.Spatial value indexed for a table's centroids.
+type geoPoint struct {
+	id       int
+	lat, lon float64
+}
+
+func (p *geoPoint) Bounds() rtreego.Rect {
+	rect, _ := rtreego.NewRect(rtreego.Point{p.lon, p.lat}, []float64{1e-9, 1e-9})
+	return rect
+}
+
+var spatialIndexes = map[string]*rtreego.Rtree{}
+
+// loadSpatialIndexes builds the in-memory R-tree of centroids used by
+// geocodeMySQL. It's a no-op on Postgres, which uses PostGIS instead.
+func loadSpatialIndexes() {
+	if driver == "postgres" {
+		return
+	}
+
+	for _, level := range geoLevels {
+		tree := rtreego.NewTree(2, 25, 50)
+		rows, err := db.Query(fmt.Sprintf("SELECT id, centroid_lat, centroid_lon FROM %s WHERE centroid_lat IS NOT NULL AND centroid_lon IS NOT NULL", level.table))
+		if err != nil {
+			slog.Error("Error loading spatial index", "table", level.table, "err", err)
+			continue
+		}
+
+		count := 0
+		for rows.Next() {
+			var id int
+			var lat, lon float64
+			if err := rows.Scan(&id, &lat, &lon); err != nil {
+				slog.Error("Error scanning centroid row", "table", level.table, "err", err)
+				continue
+			}
+			tree.Insert(&geoPoint{id: id, lat: lat, lon: lon})
+			count++
+		}
+		rows.Close()
+
+		spatialIndexes[level.table] = tree
+		slog.Info("Loaded spatial index", "table", level.table, "count", count)
+	}
+}
+
+// nearestInTable returns the id of the row in table whose centroid is
+// closest to (lat, lon).
+func nearestInTable(table string, lat, lon float64) (int, bool) {
+	tree := spatialIndexes[table]
+	if tree == nil {
+		return 0, false
+	}
+	results := tree.NearestNeighbors(1, rtreego.Point{lon, lat})
+	if len(results) == 0 {
+		return 0, false
+	}
+	point, ok := results[0].(*geoPoint)
+	if !ok {
+		return 0, false
+	}
+	return point.id, true
+}
+
+// geoJSONGeometry mirrors the geometry member of a GeoJSON Feature, kept
+// deliberately loose (raw coordinate tree) since import-geo only needs to
+// compute a centroid and pass the geometry through to storage untouched.
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   json.RawMessage        `json:"geometry"`
+}
+
+type geoJSONFeatureCollection struct {
+	Features []geoJSONFeature `json:"features"`
+}
+
+// runImportGeo implements the `import-geo` CLI subcommand: it reads a
+// GeoJSON FeatureCollection (convert BPS shapefiles to GeoJSON first,
+// e.g. with `ogr2ogr -f GeoJSON out.geojson in.shp`) and, for each
+// feature, stores its geometry and an approximate centroid against the
+// matching row of the given level's table.
+func runImportGeo(level, file string) {
+	var table string
+	switch level {
+	case "provinsi":
+		table = "provinsis"
+	case "kabupaten":
+		table = "kab_kotas"
+	case "kecamatan":
+		table = "kecamatans"
+	case "kelurahan":
+		table = "kelurahan_desas"
+	default:
+		slog.Error("Unknown --level: expected provinsi, kabupaten, kecamatan or kelurahan", "level", level)
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		slog.Error("Error reading file", "file", file, "err", err)
+		os.Exit(1)
+	}
+
+	var fc geoJSONFeatureCollection
+	if err := json.Unmarshal(data, &fc); err != nil {
+		slog.Error("Error parsing file as GeoJSON", "file", file, "err", err)
+		os.Exit(1)
+	}
+
+	imported := 0
+	for _, feature := range fc.Features {
+		id, err := featureID(feature)
+		if err != nil {
+			slog.Info("Skipping feature without a usable id", "err", err)
+			continue
+		}
+
+		var geom geoJSONGeometry
+		if err := json.Unmarshal(feature.Geometry, &geom); err != nil {
+			slog.Info("Skipping feature with unparseable geometry", "id", id, "err", err)
+			continue
+		}
+		lat, lon := approximateCentroid(geom.Coordinates)
+
+		if err := storeGeometry(table, id, lat, lon, string(feature.Geometry)); err != nil {
+			slog.Error("Error storing geometry", "table", table, "id", id, "err", err)
+			continue
+		}
+		imported++
+	}
+
+	slog.Info("Imported geometry", "table", table, "imported", imported, "total", len(fc.Features))
+}
+
+func featureID(feature geoJSONFeature) (int, error) {
+	raw, ok := feature.Properties["id"]
+	if !ok {
+		return 0, fmt.Errorf("feature has no \"id\" property")
+	}
+	switch v := raw.(type) {
+	case float64:
+		return int(v), nil
+	case string:
+		return strconv.Atoi(v)
+	default:
+		return 0, fmt.Errorf("unsupported id type %T", raw)
+	}
+}
+
+// approximateCentroid averages every coordinate pair found anywhere in a
+// GeoJSON coordinates tree (Polygon or MultiPolygon). This is a simple
+// vertex average, not an area-weighted centroid, which is accurate
+// enough for nearest-neighbor geocoding without pulling in a full
+// computational-geometry dependency.
+func approximateCentroid(coordinates interface{}) (lat, lon float64) {
+	var sumLat, sumLon float64
+	var count int
+
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch v := node.(type) {
+		case []interface{}:
+			if len(v) == 2 {
+				lonVal, okLon := v[0].(float64)
+				latVal, okLat := v[1].(float64)
+				if okLon && okLat {
+					sumLon += lonVal
+					sumLat += latVal
+					count++
+					return
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(coordinates)
+
+	if count == 0 {
+		return 0, 0
+	}
+	return sumLat / float64(count), sumLon / float64(count)
+}
+
+func storeGeometry(table string, id int, lat, lon float64, boundaryGeoJSON string) error {
+	if driver == "postgres" {
+		query := convertToPostgres(fmt.Sprintf(
+			"UPDATE %s SET centroid_lat = ?, centroid_lon = ?, boundary = ST_SetSRID(ST_Multi(ST_GeomFromGeoJSON(?)), 4326) WHERE id = ?",
+			table))
+		_, err := db.Exec(query, lat, lon, boundaryGeoJSON, id)
+		return err
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET centroid_lat = ?, centroid_lon = ?, boundary = ? WHERE id = ?", table)
+	_, err := db.Exec(query, lat, lon, boundaryGeoJSON, id)
+	return err
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/gnomefin/api-wilayah-indonesia/proto"
+)
+
+// startGRPCGateway starts the gRPC server on grpcAddr (not exposed
+// publicly) and returns an http.Handler that proxies REST requests to it
+// via grpc-gateway. It is mounted into the existing Gin router under
+// /v2 so the original routes keep working unchanged on the same port.
+func startGRPCGateway(grpcAddr string) (http.Handler, error) {
+	grpcServer := grpc.NewServer()
+	pb.RegisterProvinsiServiceServer(grpcServer, &provinsiServer{})
+	pb.RegisterKabupatenServiceServer(grpcServer, &kabupatenServer{})
+	pb.RegisterKecamatanServiceServer(grpcServer, &kecamatanServer{})
+	pb.RegisterKelurahanServiceServer(grpcServer, &kelurahanServer{})
+
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", grpcAddr, err)
+	}
+
+	go func() {
+		slog.Info("Serving gRPC", "addr", grpcAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			slog.Error("gRPC server stopped", "err", err)
+			os.Exit(1)
+		}
+	}()
+
+	ctx := context.Background()
+	mux := runtime.NewServeMux()
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	registrars := []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		pb.RegisterProvinsiServiceHandlerFromEndpoint,
+		pb.RegisterKabupatenServiceHandlerFromEndpoint,
+		pb.RegisterKecamatanServiceHandlerFromEndpoint,
+		pb.RegisterKelurahanServiceHandlerFromEndpoint,
+	}
+	for _, register := range registrars {
+		if err := register(ctx, mux, grpcAddr, dialOpts); err != nil {
+			return nil, fmt.Errorf("registering gateway handler: %w", err)
+		}
+	}
+
+	return mux, nil
+}
+
+// errFromResult maps a (value, status) pair produced by the existing
+// fetch* helpers onto a gRPC status error, so the gRPC/REST gateway path
+// reuses the same data access code as the plain Gin handlers.
+func errFromResult(value interface{}, httpStatus int) error {
+	message := "internal error"
+	if h, ok := value.(gin.H); ok {
+		if e, ok := h["error"].(string); ok {
+			message = e
+		}
+	}
+	if httpStatus == http.StatusNotFound {
+		return status.Error(codes.NotFound, message)
+	}
+	return status.Error(codes.Internal, message)
+}
+
+func toProtoItem(value interface{}) *pb.Item {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return &pb.Item{}
+	}
+	id, _ := m["id"].(int)
+	nama, _ := m["nama"].(string)
+	return &pb.Item{Id: int64(id), Nama: nama}
+}
+
+func toProtoListResponse(value interface{}) *pb.ListResponse {
+	items, _ := value.([]map[string]interface{})
+	resp := &pb.ListResponse{}
+	for _, it := range items {
+		id, _ := it["id"].(int)
+		nama, _ := it["nama"].(string)
+		resp.Items = append(resp.Items, &pb.Item{Id: int64(id), Nama: nama})
+	}
+	return resp
+}
+
+type provinsiServer struct {
+	pb.UnimplementedProvinsiServiceServer
+}
+
+func (s *provinsiServer) ListProvinsi(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	value, httpStatus := fetchItems("provinsis", "nama_provinsi", req.GetSearch(), int(req.GetPage()), int(req.GetLimit()))
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoListResponse(value), nil
+}
+
+func (s *provinsiServer) GetProvinsi(ctx context.Context, req *pb.GetRequest) (*pb.Item, error) {
+	value, httpStatus := fetchDetailItem("provinsis", "nama_provinsi", fmt.Sprintf("%d", req.GetId()), "kab_kotas", "kecamatans", "kelurahan_desas")
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoItem(value), nil
+}
+
+type kabupatenServer struct {
+	pb.UnimplementedKabupatenServiceServer
+}
+
+func (s *kabupatenServer) ListKabupaten(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	value, httpStatus := fetchItems("kab_kotas", "nama_kab_kota", req.GetSearch(), int(req.GetPage()), int(req.GetLimit()))
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoListResponse(value), nil
+}
+
+func (s *kabupatenServer) GetKabupaten(ctx context.Context, req *pb.GetRequest) (*pb.Item, error) {
+	value, httpStatus := fetchDetailItem("kab_kotas", "nama_kab_kota", fmt.Sprintf("%d", req.GetId()), "kecamatans", "kelurahan_desas")
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoItem(value), nil
+}
+
+func (s *kabupatenServer) ListKabupatenByProvinsi(ctx context.Context, req *pb.ChildrenRequest) (*pb.ListResponse, error) {
+	value, httpStatus := fetchChildItems("provinsis", "kab_kotas", "provinsi_id", "nama_kab_kota", fmt.Sprintf("%d", req.GetParentId()))
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoListResponse(value), nil
+}
+
+type kecamatanServer struct {
+	pb.UnimplementedKecamatanServiceServer
+}
+
+func (s *kecamatanServer) ListKecamatan(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	value, httpStatus := fetchItems("kecamatans", "nama_kecamatan", req.GetSearch(), int(req.GetPage()), int(req.GetLimit()))
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoListResponse(value), nil
+}
+
+func (s *kecamatanServer) GetKecamatan(ctx context.Context, req *pb.GetRequest) (*pb.Item, error) {
+	value, httpStatus := fetchDetailItem("kecamatans", "nama_kecamatan", fmt.Sprintf("%d", req.GetId()), "kelurahan_desas")
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoItem(value), nil
+}
+
+func (s *kecamatanServer) ListKecamatanByKabupaten(ctx context.Context, req *pb.ChildrenRequest) (*pb.ListResponse, error) {
+	value, httpStatus := fetchChildItems("kab_kotas", "kecamatans", "kab_kota_id", "nama_kecamatan", fmt.Sprintf("%d", req.GetParentId()))
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoListResponse(value), nil
+}
+
+// kelurahanServer streams ListKelurahan/ListKelurahanByKecamatan rather
+// than buffering a full []Item, since an unfiltered scan can return
+// hundreds of thousands of rows.
+type kelurahanServer struct {
+	pb.UnimplementedKelurahanServiceServer
+}
+
+func (s *kelurahanServer) GetKelurahan(ctx context.Context, req *pb.GetRequest) (*pb.Item, error) {
+	value, httpStatus := fetchDetailItem("kelurahan_desas", "nama_kelurahan_desa", fmt.Sprintf("%d", req.GetId()))
+	if httpStatus != http.StatusOK {
+		return nil, errFromResult(value, httpStatus)
+	}
+	return toProtoItem(value), nil
+}
+
+// ListKelurahan streams every matching row straight off the db cursor via
+// streamItems, rather than going through fetchItems (which pages the
+// result), since an unfiltered scan can return hundreds of thousands of
+// rows.
+func (s *kelurahanServer) ListKelurahan(req *pb.ListRequest, stream pb.KelurahanService_ListKelurahanServer) error {
+	err := streamItems("kelurahan_desas", "nama_kelurahan_desa", req.GetSearch(), func(id int, nama string) error {
+		return stream.Send(&pb.Item{Id: int64(id), Nama: nama})
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (s *kelurahanServer) ListKelurahanByKecamatan(req *pb.ChildrenRequest, stream pb.KelurahanService_ListKelurahanByKecamatanServer) error {
+	err := streamChildItems("kelurahan_desas", "kecamatan_id", "nama_kelurahan_desa", fmt.Sprintf("%d", req.GetParentId()), func(id int, nama string) error {
+		return stream.Send(&pb.Item{Id: int64(id), Nama: nama})
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
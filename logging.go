@@ -0,0 +1,26 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// initLogger installs a JSON slog handler as the default logger, with
+// its level controlled by LOG_LEVEL (debug, info, warn or error;
+// defaults to info). JSON output is what the Kubernetes-style log
+// collectors this service targets expect.
+func initLogger() {
+	level := slog.LevelInfo
+	switch strings.ToLower(os.Getenv("LOG_LEVEL")) {
+	case "debug":
+		level = slog.LevelDebug
+	case "warn", "warning":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	}
+
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(handler))
+}
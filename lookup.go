@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getLookupKabupaten returns the full provinsi->kabupaten ancestry for a
+// single kabupaten id, plus its sibling kabupaten under the same provinsi,
+// using one JOIN instead of the one-query-per-level pattern used by
+// getDetailItem/getChildItems.
+func getLookupKabupaten(c *gin.Context) {
+	id := c.Param("id")
+	slog.Info("Handling lookup request", "table", "kab_kotas", "id", id)
+
+	query := `SELECT p.id, p.nama_provinsi, k.id, k.nama_kab_kota
+		FROM kab_kotas k
+		JOIN provinsis p ON k.provinsi_id = p.id
+		WHERE k.id = ?`
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	var provinsiID int
+	var provinsiNama string
+	var kabupatenID int
+	var kabupatenNama string
+	if err := db.QueryRow(query, id).Scan(&provinsiID, &provinsiNama, &kabupatenID, &kabupatenNama); err != nil {
+		slog.Error("Error retrieving kabupaten lookup", "id", id, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	siblings, err := getSiblingItems("kab_kotas", "nama_kab_kota", "provinsi_id", fmt.Sprintf("%d", provinsiID), kabupatenID)
+	if err != nil {
+		slog.Error("Error retrieving kabupaten siblings", "provinsi_id", provinsiID, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"provinsi":  gin.H{"id": provinsiID, "nama": provinsiNama},
+		"kabupaten": gin.H{"id": kabupatenID, "nama": kabupatenNama},
+		"siblings":  siblings,
+	})
+	slog.Info("Lookup handled successfully", "table", "kab_kotas", "id", id)
+}
+
+// getLookupKecamatan returns the full provinsi->kabupaten->kecamatan
+// ancestry for a single kecamatan id, plus its sibling kecamatan under the
+// same kabupaten.
+func getLookupKecamatan(c *gin.Context) {
+	id := c.Param("id")
+	slog.Info("Handling lookup request", "table", "kecamatans", "id", id)
+
+	query := `SELECT p.id, p.nama_provinsi, k.id, k.nama_kab_kota, c.id, c.nama_kecamatan
+		FROM kecamatans c
+		JOIN kab_kotas k ON c.kab_kota_id = k.id
+		JOIN provinsis p ON k.provinsi_id = p.id
+		WHERE c.id = ?`
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	var provinsiID int
+	var provinsiNama string
+	var kabupatenID int
+	var kabupatenNama string
+	var kecamatanID int
+	var kecamatanNama string
+	if err := db.QueryRow(query, id).Scan(&provinsiID, &provinsiNama, &kabupatenID, &kabupatenNama, &kecamatanID, &kecamatanNama); err != nil {
+		slog.Error("Error retrieving kecamatan lookup", "id", id, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	siblings, err := getSiblingItems("kecamatans", "nama_kecamatan", "kab_kota_id", fmt.Sprintf("%d", kabupatenID), kecamatanID)
+	if err != nil {
+		slog.Error("Error retrieving kecamatan siblings", "kab_kota_id", kabupatenID, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"provinsi":  gin.H{"id": provinsiID, "nama": provinsiNama},
+		"kabupaten": gin.H{"id": kabupatenID, "nama": kabupatenNama},
+		"kecamatan": gin.H{"id": kecamatanID, "nama": kecamatanNama},
+		"siblings":  siblings,
+	})
+	slog.Info("Lookup handled successfully", "table", "kecamatans", "id", id)
+}
+
+// getLookupKelurahan returns the full
+// provinsi->kabupaten->kecamatan->kelurahan ancestry for a single kelurahan
+// id, plus its sibling kelurahan under the same kecamatan.
+func getLookupKelurahan(c *gin.Context) {
+	id := c.Param("id")
+	slog.Info("Handling lookup request", "table", "kelurahan_desas", "id", id)
+
+	query := `SELECT p.id, p.nama_provinsi, k.id, k.nama_kab_kota, c.id, c.nama_kecamatan, d.id, d.nama_kelurahan_desa
+		FROM kelurahan_desas d
+		JOIN kecamatans c ON d.kecamatan_id = c.id
+		JOIN kab_kotas k ON c.kab_kota_id = k.id
+		JOIN provinsis p ON k.provinsi_id = p.id
+		WHERE d.id = ?`
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	var provinsiID int
+	var provinsiNama string
+	var kabupatenID int
+	var kabupatenNama string
+	var kecamatanID int
+	var kecamatanNama string
+	var kelurahanID int
+	var kelurahanNama string
+	if err := db.QueryRow(query, id).Scan(&provinsiID, &provinsiNama, &kabupatenID, &kabupatenNama, &kecamatanID, &kecamatanNama, &kelurahanID, &kelurahanNama); err != nil {
+		slog.Error("Error retrieving kelurahan lookup", "id", id, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	siblings, err := getSiblingItems("kelurahan_desas", "nama_kelurahan_desa", "kecamatan_id", fmt.Sprintf("%d", kecamatanID), kelurahanID)
+	if err != nil {
+		slog.Error("Error retrieving kelurahan siblings", "kecamatan_id", kecamatanID, "err", err)
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"provinsi":  gin.H{"id": provinsiID, "nama": provinsiNama},
+		"kabupaten": gin.H{"id": kabupatenID, "nama": kabupatenNama},
+		"kecamatan": gin.H{"id": kecamatanID, "nama": kecamatanNama},
+		"kelurahan": gin.H{"id": kelurahanID, "nama": kelurahanNama},
+		"siblings":  siblings,
+	})
+	slog.Info("Lookup handled successfully", "table", "kelurahan_desas", "id", id)
+}
+
+// getSiblingItems returns the other rows of tableName sharing the given
+// parent id, excluding excludeID, ordered by id ascending.
+func getSiblingItems(tableName, columnName, parentColumn, parentID string, excludeID int) ([]map[string]interface{}, error) {
+	if err := validateTable(tableName); err != nil {
+		return nil, err
+	}
+	if err := validateColumn(columnName); err != nil {
+		return nil, err
+	}
+	if err := validateColumn(parentColumn); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT id, %s FROM %s WHERE %s = ? AND id != ? ORDER BY id ASC", columnName, tableName, parentColumn)
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	rows, err := db.Query(query, parentID, excludeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []map[string]interface{}
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			slog.Error("Error scanning sibling row", "table", tableName, "err", err)
+			continue
+		}
+		items = append(items, map[string]interface{}{"id": id, "nama": name})
+	}
+	return items, nil
+}
@@ -2,8 +2,9 @@ package main
 
 import (
 	"database/sql"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strconv"
 
@@ -11,6 +12,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var db *sql.DB
@@ -20,12 +22,12 @@ func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
-	log.Printf("Environment variable %s not set, using default value: %s", key, defaultValue)
+	slog.Info("Environment variable not set, using default value", "key", key, "default", defaultValue)
 	return defaultValue
 }
 
 func initDB() {
-	log.Println("Initializing database connection...")
+	slog.Info("Initializing database connection...")
 	var err error
 	username := getEnv("DB_USERNAME", "root")
 	password := getEnv("DB_PASSWORD", "")
@@ -39,47 +41,50 @@ func initDB() {
 		dsn = fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=require&binary_parameters=yes", username, password, host, port, database)
 	}
 
-	log.Printf("Attempting to connect to %s database at %s:%s...", driver, host, port)
+	slog.Info("Attempting to connect to database", "driver", driver, "host", host, "port", port)
 	db, err = sql.Open(driver, dsn)
 	if err != nil {
-		log.Fatalf("Error connecting to database: %v", err)
+		slog.Error("Error connecting to database", "err", err)
+		os.Exit(1)
 	}
 
 	if err = db.Ping(); err != nil {
-		log.Fatalf("Error pinging database: %v", err)
+		slog.Error("Error pinging database", "err", err)
+		os.Exit(1)
 	}
 
-	log.Println("Successfully connected to the database")
+	slog.Info("Successfully connected to the database")
 }
 
-func checkTableExist() {
-	log.Println("Checking if required tables exist...")
+// checkTableExist reports whether the four wilayah tables exist and are
+// queryable. It no longer exits the process on failure: it backs
+// /readyz instead, so a transient DB hiccup marks the pod not-ready
+// rather than killing it outright.
+func checkTableExist() error {
 	tables := []string{"provinsis", "kab_kotas", "kecamatans", "kelurahan_desas"}
 	for _, table := range tables {
-		log.Printf("Checking table: %s", table)
 		if _, err := db.Query(fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", table)); err != nil {
-			log.Fatalf("Table %s does not exist: %v", table, err)
+			return fmt.Errorf("table %s does not exist: %w", table, err)
 		}
-		log.Printf("Table %s exists", table)
 	}
-	log.Println("All required tables exist")
+	return nil
 }
 
 func info(c *gin.Context) {
-	log.Println("Handling request for info endpoint")
+	slog.Debug("Handling request for info endpoint")
 	counts := make(map[string]int)
 	tables := []string{"provinsis", "kab_kotas", "kecamatans", "kelurahan_desas"}
 
 	for _, table := range tables {
 		var count int
-		log.Printf("Counting records in %s table", table)
+		slog.Debug("Counting records", "table", table)
 		if err := db.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s", table)).Scan(&count); err != nil {
-			log.Printf("Error counting %s: %v", table, err)
+			slog.Error("Error counting rows", "table", table, "err", err)
 			c.JSON(500, gin.H{"error": fmt.Sprintf("Error counting %s", table)})
 			return
 		}
 		counts[table] = count
-		log.Printf("%s count: %d", table, count)
+		slog.Info("Table count", "table", table, "count", count)
 	}
 
 	c.JSON(200, gin.H{
@@ -88,17 +93,43 @@ func info(c *gin.Context) {
 		"jumlah_kecamatan": counts["kecamatans"],
 		"jumlah_kelurahan": counts["kelurahan_desas"],
 	})
-	log.Println("Info request handled successfully")
+	slog.Info("Info request handled successfully")
 }
 
 func getItems(c *gin.Context, tableName, columnName string) {
-	log.Printf("Handling request for %s items", tableName)
+	slog.Debug("Handling request for items", "table", tableName)
 	searchQuery := c.Query("search")
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	offset := (page - 1) * limit
 
-	log.Printf("Search query: %s, Page: %d, Limit: %d", searchQuery, page, limit)
+	slog.Debug("List query parameters", "search", searchQuery, "page", page, "limit", limit)
+
+	withCache(c, "items:"+tableName, []string{searchQuery, strconv.Itoa(page), strconv.Itoa(limit)}, func() (interface{}, int) {
+		return fetchItems(tableName, columnName, searchQuery, page, limit)
+	})
+}
+
+func fetchItems(tableName, columnName, searchQuery string, page, limit int) (interface{}, int) {
+	if err := validateTable(tableName); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+	if err := validateColumn(columnName); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+
+	// The Gin handlers already default page/limit via c.DefaultQuery, but
+	// the gRPC service methods in grpc.go pass req.GetPage()/GetLimit()
+	// straight from the proto message, where an omitted field reads back
+	// as 0. Defaulting here keeps both call paths behaving the same way
+	// instead of the gRPC path producing a LIMIT 0 OFFSET 0 query.
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
 
 	query := fmt.Sprintf("SELECT id, %s FROM %s", columnName, tableName)
 	args := make([]interface{}, 0)
@@ -115,12 +146,11 @@ func getItems(c *gin.Context, tableName, columnName string) {
 		query = convertToPostgres(query)
 	}
 
-	log.Printf("Executing query: %s", query)
+	slog.Debug("Executing query", "query", query)
 	rows, err := db.Query(query, args...)
 	if err != nil {
-		log.Printf("Error executing query: %v", err)
-		c.JSON(500, gin.H{"error": err.Error()})
-		return
+		slog.Error("Query execution failed", "query", query, "err", err)
+		return gin.H{"error": err.Error()}, 500
 	}
 	defer rows.Close()
 
@@ -129,52 +159,158 @@ func getItems(c *gin.Context, tableName, columnName string) {
 		var id int
 		var name string
 		if err := rows.Scan(&id, &name); err != nil {
-			log.Printf("Error scanning row: %v", err)
+			slog.Error("Error scanning row", "table", tableName, "err", err)
 			continue
 		}
 		items = append(items, map[string]interface{}{"id": id, "nama": name})
 	}
 
-	log.Printf("Retrieved %d items from %s", len(items), tableName)
-	c.JSON(200, items)
+	slog.Debug("Retrieved items", "table", tableName, "count", len(items))
+	return items, 200
+}
+
+// streamItems runs an unpaginated, optionally search-filtered scan of
+// tableName and calls send for each row as it is read off the db cursor,
+// rather than buffering the result set into memory first. Used by the
+// streaming gRPC methods, where a full scan can return hundreds of
+// thousands of rows.
+func streamItems(tableName, columnName, searchQuery string, send func(id int, nama string) error) error {
+	if err := validateTable(tableName); err != nil {
+		return err
+	}
+	if err := validateColumn(columnName); err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("SELECT id, %s FROM %s", columnName, tableName)
+	args := make([]interface{}, 0)
+
+	if searchQuery != "" {
+		query += fmt.Sprintf(" WHERE %s LIKE ?", columnName)
+		args = append(args, "%"+searchQuery+"%")
+	}
+
+	query += " ORDER BY id ASC"
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	slog.Debug("Executing streaming query", "query", query)
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		slog.Error("Streaming query execution failed", "query", query, "err", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			slog.Error("Error scanning streamed row", "table", tableName, "err", err)
+			continue
+		}
+		if err := send(id, name); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// streamChildItems is the streaming equivalent of fetchChildItems: it scans
+// childTable for rows matching parentColumn = parentID and calls send for
+// each row as it is read, instead of buffering the result set.
+func streamChildItems(childTable, parentColumn, childColumn, parentID string, send func(id int, nama string) error) error {
+	if err := validateTable(childTable); err != nil {
+		return err
+	}
+	if err := validateColumn(parentColumn); err != nil {
+		return err
+	}
+	if err := validateColumn(childColumn); err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("SELECT id, %s FROM %s WHERE %s = ?", childColumn, childTable, parentColumn)
+	if driver == "postgres" {
+		query = convertToPostgres(query)
+	}
+
+	slog.Debug("Executing streaming query", "query", query)
+	rows, err := db.Query(query, parentID)
+	if err != nil {
+		slog.Error("Streaming query execution failed", "table", childTable, "err", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			slog.Error("Error scanning streamed row", "table", childTable, "err", err)
+			continue
+		}
+		if err := send(id, name); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
 }
 
 func getProvinsi(c *gin.Context) {
-	log.Println("Handling request for provinces")
+	slog.Debug("Handling request for provinces")
 	getItems(c, "provinsis", "nama_provinsi")
 }
 
 func getKabupatenAll(c *gin.Context) {
-	log.Println("Handling request for all kabupaten")
+	slog.Debug("Handling request for all kabupaten")
 	getItems(c, "kab_kotas", "nama_kab_kota")
 }
 
 func getKecamatanAll(c *gin.Context) {
-	log.Println("Handling request for all kecamatan")
+	slog.Debug("Handling request for all kecamatan")
 	getItems(c, "kecamatans", "nama_kecamatan")
 }
 
 func getKelurahanAll(c *gin.Context) {
-	log.Println("Handling request for all kelurahan")
+	slog.Debug("Handling request for all kelurahan")
 	getItems(c, "kelurahan_desas", "nama_kelurahan_desa")
 }
 
 func getDetailItem(c *gin.Context, tableName, columnName string, additionalCounts ...string) {
 	id := c.Param("id")
-	log.Printf("Handling request for %s detail with id: %s", tableName, id)
+	slog.Debug("Handling request for item detail", "table", tableName, "id", id)
+
+	withCache(c, "detail:"+tableName, []string{id}, func() (interface{}, int) {
+		return fetchDetailItem(tableName, columnName, id, additionalCounts...)
+	})
+}
+
+func fetchDetailItem(tableName, columnName, id string, additionalCounts ...string) (interface{}, int) {
+	if err := validateTable(tableName); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+	if err := validateColumn(columnName); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+	for _, countTable := range additionalCounts {
+		if err := validateTable(countTable); err != nil {
+			return gin.H{"error": err.Error()}, 500
+		}
+	}
 
 	query := fmt.Sprintf("SELECT id, %s FROM %s WHERE id = ?", columnName, tableName)
 	if driver == "postgres" {
 		query = convertToPostgres(query)
 	}
 
-	log.Printf("Executing query: %s", query)
+	slog.Debug("Executing query", "query", query)
 	var itemID int
 	var itemName string
 	if err := db.QueryRow(query, id).Scan(&itemID, &itemName); err != nil {
-		log.Printf("Error retrieving %s detail: %v", tableName, err)
-		c.JSON(500, gin.H{"error": err.Error()})
-		return
+		slog.Error("Error retrieving item detail", "table", tableName, "err", err)
+		return gin.H{"error": err.Error()}, 500
 	}
 
 	result := map[string]interface{}{
@@ -188,54 +324,72 @@ func getDetailItem(c *gin.Context, tableName, columnName string, additionalCount
 		if driver == "postgres" {
 			countQuery = convertToPostgres(countQuery)
 		}
-		log.Printf("Executing count query: %s", countQuery)
+		slog.Debug("Executing count query", "query", countQuery)
 		if err := db.QueryRow(countQuery, id).Scan(&count); err != nil {
-			log.Printf("Error counting %s: %v", countTable, err)
+			slog.Error("Error counting rows", "table", countTable, "err", err)
 			continue
 		}
 		result[fmt.Sprintf("jumlah_%s", countTable)] = count
-		log.Printf("Count for %s: %d", countTable, count)
+		slog.Debug("Count for table", "table", countTable, "count", count)
 	}
 
-	log.Printf("Retrieved detail for %s with id: %s", tableName, id)
-	c.JSON(200, result)
+	slog.Debug("Retrieved item detail", "table", tableName, "id", id)
+	return result, 200
 }
 
 func getDetailProvinsi(c *gin.Context) {
-	log.Println("Handling request for province detail")
+	slog.Debug("Handling request for province detail")
 	getDetailItem(c, "provinsis", "nama_provinsi", "kab_kotas", "kecamatans", "kelurahan_desas")
 }
 
 func getDetailKabupaten(c *gin.Context) {
-	log.Println("Handling request for kabupaten detail")
+	slog.Debug("Handling request for kabupaten detail")
 	getDetailItem(c, "kab_kotas", "nama_kab_kota", "kecamatans", "kelurahan_desas")
 }
 
 func getDetailKecamatan(c *gin.Context) {
-	log.Println("Handling request for kecamatan detail")
+	slog.Debug("Handling request for kecamatan detail")
 	getDetailItem(c, "kecamatans", "nama_kecamatan", "kelurahan_desas")
 }
 
 func getDetailKelurahan(c *gin.Context) {
-	log.Println("Handling request for kelurahan detail")
+	slog.Debug("Handling request for kelurahan detail")
 	getDetailItem(c, "kelurahan_desas", "nama_kelurahan_desa")
 }
 
 func getChildItems(c *gin.Context, parentTable, childTable, parentColumn, childColumn string) {
 	parentID := c.Param("id")
-	log.Printf("Handling request for %s of %s with id: %s", childTable, parentTable, parentID)
+	slog.Debug("Handling request for child items", "child_table", childTable, "parent_table", parentTable, "parent_id", parentID)
+
+	withCache(c, "children:"+parentTable+":"+childTable, []string{parentID}, func() (interface{}, int) {
+		return fetchChildItems(parentTable, childTable, parentColumn, childColumn, parentID)
+	})
+}
+
+func fetchChildItems(parentTable, childTable, parentColumn, childColumn, parentID string) (interface{}, int) {
+	if err := validateTable(parentTable); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+	if err := validateTable(childTable); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+	if err := validateColumn(parentColumn); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
+	if err := validateColumn(childColumn); err != nil {
+		return gin.H{"error": err.Error()}, 500
+	}
 
 	query := fmt.Sprintf("SELECT id, %s FROM %s WHERE %s = ?", childColumn, childTable, parentColumn)
 	if driver == "postgres" {
 		query = convertToPostgres(query)
 	}
 
-	log.Printf("Executing query: %s", query)
+	slog.Debug("Executing query", "query", query)
 	rows, err := db.Query(query, parentID)
 	if err != nil {
-		log.Printf("Error querying %s: %v", childTable, err)
-		c.JSON(500, gin.H{"error": err.Error()})
-		return
+		slog.Error("Error querying child table", "table", childTable, "err", err)
+		return gin.H{"error": err.Error()}, 500
 	}
 	defer rows.Close()
 
@@ -244,61 +398,78 @@ func getChildItems(c *gin.Context, parentTable, childTable, parentColumn, childC
 		var id int
 		var name string
 		if err := rows.Scan(&id, &name); err != nil {
-			log.Printf("Error scanning row: %v", err)
+			slog.Error("Error scanning row", "table", childTable, "err", err)
 			continue
 		}
 		items = append(items, map[string]interface{}{"id": id, "nama": name})
 	}
 
-	log.Printf("Retrieved %d %s for %s with id: %s", len(items), childTable, parentTable, parentID)
-	c.JSON(200, items)
+	slog.Debug("Retrieved child items", "child_table", childTable, "parent_table", parentTable, "parent_id", parentID, "count", len(items))
+	return items, 200
 }
 
 func getKabupaten(c *gin.Context) {
-	log.Println("Handling request for kabupaten by province")
+	slog.Debug("Handling request for kabupaten by province")
 	getChildItems(c, "provinsis", "kab_kotas", "provinsi_id", "nama_kab_kota")
 }
 
 func getKecamatan(c *gin.Context) {
-	log.Println("Handling request for kecamatan by kabupaten")
+	slog.Debug("Handling request for kecamatan by kabupaten")
 	getChildItems(c, "kab_kotas", "kecamatans", "kab_kota_id", "nama_kecamatan")
 }
 
 func getKelurahan(c *gin.Context) {
-	log.Println("Handling request for kelurahan by kecamatan")
+	slog.Debug("Handling request for kelurahan by kecamatan")
 	getChildItems(c, "kecamatans", "kelurahan_desas", "kecamatan_id", "nama_kelurahan_desa")
 }
 
-func convertToPostgres(query string) string {
-	log.Println("Converting MySQL query to PostgreSQL format")
-	paramCount := 1
-	for i := 0; i < len(query); i++ {
-		if query[i] == '?' {
-			query = query[:i] + fmt.Sprintf("$%d", paramCount) + query[i+1:]
-			paramCount++
-		}
+func main() {
+	initLogger()
+
+	if len(os.Args) > 1 && os.Args[1] == "import-geo" {
+		importFlags := flag.NewFlagSet("import-geo", flag.ExitOnError)
+		level := importFlags.String("level", "", "wilayah level to import geometry for: provinsi, kabupaten, kecamatan, kelurahan")
+		file := importFlags.String("file", "", "path to a GeoJSON FeatureCollection")
+		importFlags.Parse(os.Args[2:])
+
+		initDB()
+		defer db.Close()
+		runImportGeo(*level, *file)
+		return
 	}
-	log.Printf("Converted query: %s", query)
-	return query
-}
 
-func main() {
-	log.Println("Starting application...")
+	seedFlag := flag.Bool("seed", false, "force (re-)loading the bundled seed dataset after migrating")
+	flag.Parse()
+
+	slog.Info("Starting application...")
 	if err := godotenv.Load(".env"); err != nil {
-		log.Println("No .env file found, using default environment variables")
+		slog.Info("No .env file found, using default environment variables")
 	} else {
-		log.Println("Loaded environment variables from .env file")
+		slog.Info("Loaded environment variables from .env file")
 	}
 
 	port := getEnv("PORT", "8080")
-	log.Printf("Using port: %s", port)
+	slog.Info("Using port", "port", port)
 
+	initCache()
 	initDB()
-	checkTableExist()
+	runMigrations()
+	if *seedFlag || isDatabaseEmpty() {
+		seedDatabase()
+	}
+	if err := checkTableExist(); err != nil {
+		slog.Warn("Startup readiness check failed, will keep retrying via /readyz", "err", err)
+	}
+	loadSpatialIndexes()
 	defer db.Close()
 
-	log.Println("Setting up Gin router...")
+	slog.Info("Setting up Gin router...")
 	router := gin.Default()
+	router.Use(metricsMiddleware())
+
+	router.GET("/healthz", healthz)
+	router.GET("/readyz", readyz)
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	router.GET("/", info)
 	router.GET("/provinsi", getProvinsi)
@@ -313,6 +484,24 @@ func main() {
 	router.GET("/kecamatan/:id/kelurahan", getKelurahan)
 	router.GET("/kelurahan/:id", getDetailKelurahan)
 
-	log.Printf("Starting server on 0.0.0.0:%s", port)
-	router.Run("0.0.0.0:" + port)
+	router.GET("/lookup/kota/:id", getLookupKabupaten)
+	router.GET("/lookup/kecamatan/:id", getLookupKecamatan)
+	router.GET("/lookup/kelurahan/:id", getLookupKelurahan)
+
+	router.GET("/geocode", geocode)
+	router.GET("/kelurahan/:id/geometry", getKelurahanGeometry)
+
+	router.POST("/admin/cache/purge", purgeCache)
+
+	grpcAddr := getEnv("GRPC_ADDR", "localhost:9090")
+	gateway, err := startGRPCGateway(grpcAddr)
+	if err != nil {
+		slog.Error("Error starting gRPC gateway", "err", err)
+		os.Exit(1)
+	}
+	router.Any("/v2/*path", gin.WrapH(gateway))
+
+	addr := "0.0.0.0:" + port
+	slog.Info("Starting server", "addr", addr)
+	router.Run(addr)
 }
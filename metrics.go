@@ -0,0 +1,72 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "wilayah_http_requests_total",
+		Help: "Total HTTP requests, labeled by route, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "wilayah_http_request_duration_seconds",
+		Help:    "HTTP request latency in seconds, labeled by route and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	dbOpenConnections = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "wilayah_db_open_connections",
+		Help: "Number of open connections in the database pool.",
+	}, func() float64 { return float64(db.Stats().OpenConnections) })
+
+	dbInUseConnections = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "wilayah_db_in_use_connections",
+		Help: "Number of database connections currently in use.",
+	}, func() float64 { return float64(db.Stats().InUse) })
+)
+
+// metricsMiddleware records a request counter and latency histogram for
+// every request, labeled by the matched route template (not the raw
+// path) to keep cardinality bounded on :id routes.
+func metricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		httpRequestsTotal.WithLabelValues(route, c.Request.Method, strconv.Itoa(c.Writer.Status())).Inc()
+		httpRequestDuration.WithLabelValues(route, c.Request.Method).Observe(time.Since(start).Seconds())
+	}
+}
+
+// healthz is the liveness probe: it only confirms the process is up,
+// without touching the database.
+func healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// readyz is the readiness probe: it pings the database and confirms the
+// four wilayah tables are reachable, so Kubernetes stops routing traffic
+// here during a transient DB outage instead of the pod being restarted.
+func readyz(c *gin.Context) {
+	if err := db.Ping(); err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not ready", "error": err.Error()})
+		return
+	}
+	if err := checkTableExist(); err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not ready", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ready"})
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+//go:embed seed/*.csv
+var seedFS embed.FS
+
+// mysqlSchema creates the four wilayah tables and their lookup indexes.
+// Statements run individually (rather than as one multi-statement Exec)
+// since the mysql driver DSN built in initDB does not enable
+// multiStatements.
+var mysqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS provinsis (
+		id INT PRIMARY KEY,
+		nama_provinsi VARCHAR(255) NOT NULL,
+		centroid_lat DOUBLE NULL,
+		centroid_lon DOUBLE NULL,
+		boundary JSON NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS kab_kotas (
+		id INT PRIMARY KEY,
+		nama_kab_kota VARCHAR(255) NOT NULL,
+		provinsi_id INT NOT NULL,
+		centroid_lat DOUBLE NULL,
+		centroid_lon DOUBLE NULL,
+		boundary JSON NULL,
+		INDEX idx_kab_kotas_provinsi_id (provinsi_id),
+		FOREIGN KEY (provinsi_id) REFERENCES provinsis(id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS kecamatans (
+		id INT PRIMARY KEY,
+		nama_kecamatan VARCHAR(255) NOT NULL,
+		kab_kota_id INT NOT NULL,
+		centroid_lat DOUBLE NULL,
+		centroid_lon DOUBLE NULL,
+		boundary JSON NULL,
+		INDEX idx_kecamatans_kab_kota_id (kab_kota_id),
+		FOREIGN KEY (kab_kota_id) REFERENCES kab_kotas(id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS kelurahan_desas (
+		id INT PRIMARY KEY,
+		nama_kelurahan_desa VARCHAR(255) NOT NULL,
+		kecamatan_id INT NOT NULL,
+		centroid_lat DOUBLE NULL,
+		centroid_lon DOUBLE NULL,
+		boundary JSON NULL,
+		INDEX idx_kelurahan_desas_kecamatan_id (kecamatan_id),
+		FOREIGN KEY (kecamatan_id) REFERENCES kecamatans(id)
+	)`,
+}
+
+// postgresSchema is the Postgres equivalent of mysqlSchema. Postgres does
+// not support an inline INDEX clause in CREATE TABLE, so indexes are
+// created as separate statements.
+var postgresSchema = []string{
+	`CREATE EXTENSION IF NOT EXISTS postgis`,
+	`CREATE TABLE IF NOT EXISTS provinsis (
+		id INTEGER PRIMARY KEY,
+		nama_provinsi VARCHAR(255) NOT NULL,
+		centroid_lat DOUBLE PRECISION,
+		centroid_lon DOUBLE PRECISION,
+		boundary GEOMETRY(MultiPolygon, 4326)
+	)`,
+	`CREATE TABLE IF NOT EXISTS kab_kotas (
+		id INTEGER PRIMARY KEY,
+		nama_kab_kota VARCHAR(255) NOT NULL,
+		provinsi_id INTEGER NOT NULL REFERENCES provinsis(id),
+		centroid_lat DOUBLE PRECISION,
+		centroid_lon DOUBLE PRECISION,
+		boundary GEOMETRY(MultiPolygon, 4326)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_kab_kotas_provinsi_id ON kab_kotas(provinsi_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_kab_kotas_boundary ON kab_kotas USING GIST(boundary)`,
+	`CREATE TABLE IF NOT EXISTS kecamatans (
+		id INTEGER PRIMARY KEY,
+		nama_kecamatan VARCHAR(255) NOT NULL,
+		kab_kota_id INTEGER NOT NULL REFERENCES kab_kotas(id),
+		centroid_lat DOUBLE PRECISION,
+		centroid_lon DOUBLE PRECISION,
+		boundary GEOMETRY(MultiPolygon, 4326)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_kecamatans_kab_kota_id ON kecamatans(kab_kota_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_kecamatans_boundary ON kecamatans USING GIST(boundary)`,
+	`CREATE TABLE IF NOT EXISTS kelurahan_desas (
+		id INTEGER PRIMARY KEY,
+		nama_kelurahan_desa VARCHAR(255) NOT NULL,
+		kecamatan_id INTEGER NOT NULL REFERENCES kecamatans(id),
+		centroid_lat DOUBLE PRECISION,
+		centroid_lon DOUBLE PRECISION,
+		boundary GEOMETRY(MultiPolygon, 4326)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_kelurahan_desas_kecamatan_id ON kelurahan_desas(kecamatan_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_kelurahan_desas_boundary ON kelurahan_desas USING GIST(boundary)`,
+}
+
+// runMigrations creates the provinsis/kab_kotas/kecamatans/kelurahan_desas
+// tables if they don't already exist, so the service can be deployed
+// against an empty database without a separate SQL dump.
+func runMigrations() {
+	slog.Info("Running migrations", "driver", driver)
+	schema := mysqlSchema
+	if driver == "postgres" {
+		schema = postgresSchema
+	}
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			slog.Error("Error running migration", "statement", stmt, "err", err)
+			os.Exit(1)
+		}
+	}
+	slog.Info("Migrations complete")
+}
+
+// isDatabaseEmpty reports whether the provinsis table has no rows yet,
+// which is used to trigger an automatic first-run seed.
+func isDatabaseEmpty() bool {
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM provinsis").Scan(&count); err != nil {
+		slog.Error("Error checking if database is empty", "err", err)
+		return false
+	}
+	return count == 0
+}
+
+// seedSpec describes one embedded seed CSV and the table it loads into.
+// The CSV's first column is always the row id; columns lists the
+// remaining columns in file order.
+type seedSpec struct {
+	file    string
+	table   string
+	columns []string
+}
+
+var seedSpecs = []seedSpec{
+	{"seed/provinsi.csv", "provinsis", []string{"nama_provinsi"}},
+	{"seed/kab_kota.csv", "kab_kotas", []string{"nama_kab_kota", "provinsi_id"}},
+	{"seed/kecamatan.csv", "kecamatans", []string{"nama_kecamatan", "kab_kota_id"}},
+	{"seed/kelurahan_desa.csv", "kelurahan_desas", []string{"nama_kelurahan_desa", "kecamatan_id"}},
+}
+
+// seedDatabase loads the bundled Kemendagri-style sample codes from the
+// embedded seed/*.csv files. It is idempotent: rows that already exist
+// (matched by id) are skipped rather than erroring.
+//
+// The full official Kemendagri wilayah dataset has on the order of
+// 80,000 kelurahan rows; the bundled CSVs only carry a small
+// representative sample so the binary stays lightweight. Drop a complete
+// export into seed/ with the same column layout to seed a full dataset.
+func seedDatabase() {
+	slog.Info("Seeding database from bundled seed data...")
+	for _, spec := range seedSpecs {
+		if err := seedTable(spec); err != nil {
+			slog.Error("Error seeding table", "table", spec.table, "err", err)
+			os.Exit(1)
+		}
+	}
+	slog.Info("Seeding complete")
+}
+
+func seedTable(spec seedSpec) error {
+	f, err := seedFS.Open(spec.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(rows) < 2 {
+		return nil
+	}
+
+	allColumns := append([]string{"id"}, spec.columns...)
+	placeholders := make([]string, len(allColumns))
+	for i := range placeholders {
+		placeholders[i] = "?"
+	}
+
+	query := fmt.Sprintf("INSERT IGNORE INTO %s (%s) VALUES (%s)", spec.table, strings.Join(allColumns, ", "), strings.Join(placeholders, ", "))
+	if driver == "postgres" {
+		query = fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (id) DO NOTHING", spec.table, strings.Join(allColumns, ", "), strings.Join(placeholders, ", "))
+		query = convertToPostgres(query)
+	}
+
+	inserted := 0
+	for _, row := range rows[1:] {
+		args := make([]interface{}, len(row))
+		for i, v := range row {
+			args[i] = v
+		}
+		if _, err := db.Exec(query, args...); err != nil {
+			return err
+		}
+		inserted++
+	}
+	slog.Info("Seeded rows", "table", spec.table, "count", inserted)
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// allowedTables whitelists every table name this service is allowed to
+// interpolate into a query. Table/column names can't be parameterized
+// with driver placeholders, so anything reaching fmt.Sprintf here must
+// first be checked against this list rather than trusted as-is.
+var allowedTables = map[string]bool{
+	"provinsis":       true,
+	"kab_kotas":       true,
+	"kecamatans":      true,
+	"kelurahan_desas": true,
+}
+
+// allowedColumns whitelists every column name this service is allowed to
+// interpolate into a query, for the same reason as allowedTables.
+var allowedColumns = map[string]bool{
+	"nama_provinsi":       true,
+	"nama_kab_kota":       true,
+	"nama_kecamatan":      true,
+	"nama_kelurahan_desa": true,
+	"provinsi_id":         true,
+	"kab_kota_id":         true,
+	"kecamatan_id":        true,
+}
+
+// validateTable returns an error if table is not one of the known
+// wilayah tables.
+func validateTable(table string) error {
+	if !allowedTables[table] {
+		return fmt.Errorf("unknown table %q", table)
+	}
+	return nil
+}
+
+// validateColumn returns an error if column is not one of the known
+// wilayah columns.
+func validateColumn(column string) error {
+	if !allowedColumns[column] {
+		return fmt.Errorf("unknown column %q", column)
+	}
+	return nil
+}
+
+// convertToPostgres rewrites MySQL-style "?" placeholders into
+// PostgreSQL-style "$1", "$2", ... placeholders. It skips over
+// single-quoted string literals so a literal "?" embedded in one (e.g.
+// inside a default value) isn't mistaken for a placeholder - the previous
+// version scanned the whole query blindly. SQL comments are not skipped:
+// a "?" inside -- or /* */ is still rewritten.
+func convertToPostgres(query string) string {
+	var out strings.Builder
+	paramCount := 1
+	inQuote := false
+
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+		switch {
+		case ch == '\'':
+			inQuote = !inQuote
+			out.WriteByte(ch)
+		case ch == '?' && !inQuote:
+			out.WriteString(fmt.Sprintf("$%d", paramCount))
+			paramCount++
+		default:
+			out.WriteByte(ch)
+		}
+	}
+	return out.String()
+}
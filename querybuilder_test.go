@@ -0,0 +1,128 @@
+package main
+
+import (
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestConvertToPostgres(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "no placeholders",
+			query: "SELECT 1",
+			want:  "SELECT 1",
+		},
+		{
+			name:  "multiple placeholders",
+			query: "SELECT id FROM t WHERE a = ? AND b = ?",
+			want:  "SELECT id FROM t WHERE a = $1 AND b = $2",
+		},
+		{
+			name:  "question mark inside a quoted literal is left untouched",
+			query: "SELECT id FROM t WHERE note = 'what?' AND a = ?",
+			want:  "SELECT id FROM t WHERE note = 'what?' AND a = $1",
+		},
+		{
+			name:  "quote characters are preserved",
+			query: "SELECT id FROM t WHERE a = ? AND name = 'O''Brien'",
+			want:  "SELECT id FROM t WHERE a = $1 AND name = 'O''Brien'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertToPostgres(tt.query); got != tt.want {
+				t.Errorf("convertToPostgres(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeSQLDriver is a minimal database/sql/driver.Driver that records the
+// last query and args it was asked to run, so fetchItems can be tested
+// without a real MySQL/Postgres connection.
+type fakeSQLDriver struct {
+	query string
+	args  []sqldriver.Value
+}
+
+func (d *fakeSQLDriver) Open(name string) (sqldriver.Conn, error) {
+	return &fakeSQLConn{d: d}, nil
+}
+
+type fakeSQLConn struct{ d *fakeSQLDriver }
+
+func (c *fakeSQLConn) Prepare(query string) (sqldriver.Stmt, error) {
+	return &fakeSQLStmt{conn: c, query: query}, nil
+}
+func (c *fakeSQLConn) Close() error { return nil }
+func (c *fakeSQLConn) Begin() (sqldriver.Tx, error) {
+	return nil, errors.New("fakeSQLConn: transactions not supported")
+}
+
+type fakeSQLStmt struct {
+	conn  *fakeSQLConn
+	query string
+}
+
+func (s *fakeSQLStmt) Close() error  { return nil }
+func (s *fakeSQLStmt) NumInput() int { return -1 }
+func (s *fakeSQLStmt) Exec(args []sqldriver.Value) (sqldriver.Result, error) {
+	return nil, errors.New("fakeSQLStmt: Exec not supported")
+}
+func (s *fakeSQLStmt) Query(args []sqldriver.Value) (sqldriver.Rows, error) {
+	s.conn.d.query = s.query
+	s.conn.d.args = args
+	return &fakeSQLRows{}, nil
+}
+
+// fakeSQLRows always reports no rows; fetchItems only cares about the
+// query and args it was given here, not the result set.
+type fakeSQLRows struct{}
+
+func (r *fakeSQLRows) Columns() []string                 { return []string{"id", "nama"} }
+func (r *fakeSQLRows) Close() error                      { return nil }
+func (r *fakeSQLRows) Next(dest []sqldriver.Value) error { return io.EOF }
+
+// TestFetchItemsBindsSearchQueryAsArgument guards against regressing to a
+// fmt.Sprintf-built search clause: %, _ and ' in the search term must
+// reach the database as a single bound LIKE argument, not get mixed into
+// the SQL text.
+func TestFetchItemsBindsSearchQueryAsArgument(t *testing.T) {
+	fd := &fakeSQLDriver{}
+	sql.Register("wilayahtest_fetchitems", fd)
+
+	testDB, err := sql.Open("wilayahtest_fetchitems", "")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer testDB.Close()
+
+	origDB, origDriver := db, driver
+	db, driver = testDB, "mysql"
+	defer func() { db, driver = origDB, origDriver }()
+
+	search := `100% off_ O'Brien`
+	if _, status := fetchItems("provinsis", "nama_provinsi", search, 1, 10); status != 200 {
+		t.Fatalf("fetchItems returned status %d, want 200", status)
+	}
+
+	if !strings.Contains(fd.query, "LIKE ?") {
+		t.Errorf("query = %q, want it to bind the search term via a LIKE ? placeholder", fd.query)
+	}
+	if len(fd.args) == 0 {
+		t.Fatalf("expected at least one bound argument, got none")
+	}
+	if want := "%" + search + "%"; fmt.Sprint(fd.args[0]) != want {
+		t.Errorf("bound LIKE argument = %q, want %q", fd.args[0], want)
+	}
+}